@@ -0,0 +1,39 @@
+package ethstorageproof
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/vocdoni/storage-proofs-eth-go/helpers"
+)
+
+// FetchProof calls the eth_getProof web3 method for contractAddr and keys
+// against ethCli/rpcCli, and fans block's state root/height onto the
+// result. If block is nil, the proof at the latest block is retrieved.
+// This is the single eth_getProof round-trip shared by every token
+// package's single-holder proof method.
+func FetchProof(ctx context.Context, rpcCli *rpc.Client, ethCli *ethclient.Client,
+	contractAddr common.Address, keys [][]byte, block *big.Int) (*StorageProof, error) {
+	blockData, err := ethCli.BlockByNumber(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	var resp StorageProof
+	if err := rpcCli.CallContext(
+		ctx,
+		&resp,
+		"eth_getProof",
+		fmt.Sprintf("0x%x", contractAddr),
+		SliceData(keys),
+		helpers.ToBlockNumArg(block),
+	); err != nil {
+		return nil, err
+	}
+	resp.StateRoot = blockData.Root()
+	resp.Height = blockData.Header().Number
+	return &resp, nil
+}
@@ -0,0 +1,108 @@
+package ethstorageproof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// VerifyAgainstHeader checks that proof is consistent with header, without
+// any RPC dependency: it walks the state trie rooted at header.Root down to
+// proof.Address's account leaf, checks the decoded storageHash matches the
+// proof's, and then walks each storage proof from that storageHash down to
+// its claimed value. Both tries use the same Merkle-Patricia node encoding
+// (extension/branch/leaf discriminated by prefix nibble and compact-encoded
+// paths), so a single trie walker handles both legs.
+func VerifyAgainstHeader(proof *StorageProof, header *types.Header) error {
+	if proof == nil {
+		return fmt.Errorf("nil proof")
+	}
+	if header == nil {
+		return fmt.Errorf("nil header")
+	}
+
+	accountKey := crypto.Keccak256(proof.Address.Bytes())
+	accountRLP, err := verifyTriePath(header.Root, accountKey, proof.AccountProof)
+	if err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+
+	// trie.VerifyProof returns (nil, nil) when the account is proven absent
+	// from the state trie, which is how an address with no nonce, balance,
+	// code or storage (i.e. nothing has ever touched it) looks. Treat that
+	// as the zero account instead of trying to RLP-decode an empty slice.
+	var account struct {
+		Nonce    uint64
+		Balance  *big.Int
+		Root     common.Hash
+		CodeHash []byte
+	}
+	if accountRLP != nil {
+		if err := rlp.DecodeBytes(accountRLP, &account); err != nil {
+			return fmt.Errorf("decode account leaf: %w", err)
+		}
+	}
+	if account.Root != proof.StorageHash {
+		return fmt.Errorf("storage root mismatch: header account says %s, proof claims %s",
+			account.Root, proof.StorageHash)
+	}
+
+	for _, sp := range proof.StorageProof {
+		storageKey := crypto.Keccak256(common.LeftPadBytes(sp.Key, 32))
+
+		valueRLP, err := verifyTriePath(proof.StorageHash, storageKey, sp.Proof)
+		if err != nil {
+			return fmt.Errorf("storage proof for key %x: %w", []byte(sp.Key), err)
+		}
+		// trie.VerifyProof returns (nil, nil) when key is proven absent from
+		// the trie, which is how Ethereum represents a zero-valued storage
+		// slot (it deletes them rather than storing a zero). Treat that as
+		// a value of zero instead of trying to RLP-decode an empty slice.
+		var gotValue []byte
+		if valueRLP != nil {
+			if err := rlp.DecodeBytes(valueRLP, &gotValue); err != nil {
+				return fmt.Errorf("decode storage value for key %x: %w", []byte(sp.Key), err)
+			}
+		}
+
+		want := common.LeftPadBytes(sp.Value, 32)
+		got := common.LeftPadBytes(gotValue, 32)
+		if !bytes.Equal(got, want) {
+			return fmt.Errorf("value mismatch for key %x: trie has %x, proof claims %x",
+				[]byte(sp.Key), got, want)
+		}
+	}
+
+	return nil
+}
+
+// verifyTriePath loads proofNodes (RLP-encoded trie nodes, keyed by their
+// own hash) into an in-memory database and walks from root down to key,
+// returning the RLP-encoded leaf value.
+func verifyTriePath(root common.Hash, key []byte, proofNodes SliceData) ([]byte, error) {
+	// An entirely empty trie (e.g. an account that has never been touched,
+	// or a contract with no storage) has no root node at all, so Prove
+	// yields zero proof nodes and trie.VerifyProof can't even resolve the
+	// root to check. Both common.Hash{} (what GetStorageRoot/an absent
+	// account return) and types.EmptyRootHash (the canonical empty-MPT
+	// root) denote that case; treat either, with no proof nodes, as key
+	// proven absent rather than an error.
+	if len(proofNodes) == 0 && (root == (common.Hash{}) || root == types.EmptyRootHash) {
+		return nil, nil
+	}
+
+	db := memorydb.New()
+	for _, node := range proofNodes {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	return trie.VerifyProof(root, key, db)
+}
@@ -0,0 +1,104 @@
+package ethstorageproof
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// newProvableTrie builds a trie containing entries, commits it and returns
+// a Merkle proof for key, so VerifyAgainstHeader can be exercised without
+// any RPC dependency. key need not be present in entries: proving an
+// absent key is exactly how a zero-valued (deleted) storage slot looks.
+func newProvableTrie(t *testing.T, entries map[string][]byte, key []byte) (common.Hash, SliceData) {
+	t.Helper()
+
+	tr, err := trie.New(trie.TrieID(common.Hash{}), trie.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	if err != nil {
+		t.Fatalf("new trie: %v", err)
+	}
+	for k, v := range entries {
+		if err := tr.Update([]byte(k), v); err != nil {
+			t.Fatalf("update trie: %v", err)
+		}
+	}
+	proofDB := memorydb.New()
+	if err := tr.Prove(key, proofDB); err != nil {
+		t.Fatalf("prove: %v", err)
+	}
+
+	root, _, err := tr.Commit(false)
+	if err != nil {
+		t.Fatalf("commit trie: %v", err)
+	}
+	it := proofDB.NewIterator(nil, nil)
+	defer it.Release()
+	var nodes SliceData
+	for it.Next() {
+		nodes = append(nodes, append([]byte(nil), it.Value()...))
+	}
+	return root, nodes
+}
+
+func zeroValueProof(t *testing.T, claimedValue *big.Int) (*StorageProof, *types.Header) {
+	t.Helper()
+
+	addr := common.HexToAddress("0x00000000000000000000000000000000000abc")
+	key := common.HexToHash("0x01")
+	storageKey := crypto.Keccak256(common.LeftPadBytes(key.Bytes(), 32))
+
+	// storageKey itself is never written, only an unrelated sibling slot is,
+	// so the trie has a real root but storageKey is provably absent from
+	// it, modelling a zero-valued (deleted) storage slot.
+	otherKey := crypto.Keccak256(common.LeftPadBytes(common.HexToHash("0x02").Bytes(), 32))
+	storageRoot, storageProofNodes := newProvableTrie(t,
+		map[string][]byte{string(otherKey): {0x01}}, storageKey)
+
+	accountRLP, err := rlp.EncodeToBytes(&struct {
+		Nonce    uint64
+		Balance  *big.Int
+		Root     common.Hash
+		CodeHash []byte
+	}{Nonce: 0, Balance: big.NewInt(0), Root: storageRoot, CodeHash: crypto.Keccak256(nil)})
+	if err != nil {
+		t.Fatalf("encode account: %v", err)
+	}
+	accountKey := crypto.Keccak256(addr.Bytes())
+	headerRoot, accountProofNodes := newProvableTrie(t,
+		map[string][]byte{string(accountKey): accountRLP}, accountKey)
+
+	proof := &StorageProof{
+		Address:      addr,
+		StorageHash:  storageRoot,
+		AccountProof: accountProofNodes,
+		StorageProof: []StorageResult{
+			{
+				Key:   QuantityBytes(key.Bytes()),
+				Value: QuantityBytes(claimedValue.Bytes()),
+				Proof: storageProofNodes,
+			},
+		},
+	}
+	return proof, &types.Header{Root: headerRoot}
+}
+
+func TestVerifyAgainstHeaderZeroValue(t *testing.T) {
+	proof, header := zeroValueProof(t, big.NewInt(0))
+	if err := VerifyAgainstHeader(proof, header); err != nil {
+		t.Fatalf("VerifyAgainstHeader: %v", err)
+	}
+}
+
+func TestVerifyAgainstHeaderZeroValueRejectsNonzeroClaim(t *testing.T) {
+	proof, header := zeroValueProof(t, big.NewInt(42))
+	if err := VerifyAgainstHeader(proof, header); err == nil {
+		t.Fatalf("expected mismatch error for a nonzero claim against an absent slot")
+	}
+}
@@ -0,0 +1,111 @@
+package erc1155
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+	contracts "github.com/vocdoni/storage-proofs-eth-go/ierc1155"
+)
+
+// ErrSlotNotFound represents the storage slot not found error
+var ErrSlotNotFound = errors.New("storage slot not found")
+
+const maxIterationsForDiscover = 20
+
+// ERC1155Token holds a reference to a go-ethereum client and to an ERC1155
+// like contract.
+type ERC1155Token struct {
+	RPCCli    *rpc.Client
+	EthCli    *ethclient.Client
+	token     *contracts.TokenCaller
+	TokenAddr common.Address
+}
+
+// New creates a new ERC1155Token to access ERC1155 token data and get
+// balance storage proofs
+func New(ctx context.Context, rpcCli *rpc.Client,
+	contractAddress common.Address) (*ERC1155Token, error) {
+	ethCli := ethclient.NewClient(rpcCli)
+	token, err := contracts.NewTokenCaller(contractAddress, ethCli)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155Token{
+		RPCCli:    rpcCli,
+		EthCli:    ethCli,
+		token:     token,
+		TokenAddr: contractAddress,
+	}, nil
+}
+
+// DiscoverSlot tries to find the map index slot for the `_balances`
+// mapping(uint256 => mapping(address => uint256)), brute-forcing slot
+// indices and matching the decoded storage value against balanceOf.
+//
+// A holder with a zero balance can't be discovered this way: slot 0 of an
+// untouched mapping entry is zero too, so the first index would falsely
+// "match". DiscoverSlot returns ErrSlotNotFound rather than reporting a
+// bogus slot in that case.
+func (w *ERC1155Token) DiscoverSlot(ctx context.Context, tokenID *big.Int,
+	holder common.Address) (int, *big.Int, error) {
+	balance, err := w.token.BalanceOf(&bind.CallOpts{Context: ctx}, holder, tokenID)
+	if err != nil {
+		return -1, nil, err
+	}
+	if balance.Sign() == 0 {
+		return -1, nil, ErrSlotNotFound
+	}
+
+	for i := 0; i < maxIterationsForDiscover; i++ {
+		value, err := w.EthCli.StorageAt(ctx, w.TokenAddr, balanceSlot(tokenID, holder, i), nil)
+		if err != nil {
+			return -1, nil, err
+		}
+		amount := new(big.Int).SetBytes(value)
+		if amount.Cmp(balance) == 0 {
+			return i, amount, nil
+		}
+	}
+	return -1, nil, ErrSlotNotFound
+}
+
+// GetBalanceProof returns a storage proof for holder's balance of tokenID
+// at block, discovering the `_balances` mapping slot automatically. If
+// block is nil, the proof at the latest block will be retrieved.
+func (w *ERC1155Token) GetBalanceProof(ctx context.Context, tokenID *big.Int,
+	holder common.Address, block *big.Int) (*ethstorageproof.StorageProof, error) {
+	slotIndex, _, err := w.DiscoverSlot(ctx, tokenID, holder)
+	if err != nil {
+		return nil, fmt.Errorf("cannot discover balances slot: %w", err)
+	}
+	key := balanceSlot(tokenID, holder, slotIndex)
+	return ethstorageproof.FetchProof(ctx, w.RPCCli, w.EthCli, w.TokenAddr,
+		[][]byte{key.Bytes()}, block)
+}
+
+// innerSlot computes the inner mapping(uint256 => ...) key for tokenID at
+// slotIndex: keccak256(leftPad32(tokenID) ++ leftPad32(slotIndex)).
+func innerSlot(tokenID *big.Int, slotIndex int) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(tokenID.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(int64(slotIndex)).Bytes(), 32),
+	)
+}
+
+// balanceSlot computes the storage key for the nested `_balances`
+// mapping(uint256 => mapping(address => uint256)) at slotIndex: the inner
+// key/slot pair is hashed first, then the outer holder key is hashed
+// against that result.
+func balanceSlot(tokenID *big.Int, holder common.Address, slotIndex int) common.Hash {
+	inner := innerSlot(tokenID, slotIndex)
+	return crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), 32), inner.Bytes())
+}
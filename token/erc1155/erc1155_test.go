@@ -0,0 +1,35 @@
+package erc1155
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBalanceSlotIsDeterministic(t *testing.T) {
+	holder := common.HexToAddress("0x00000000000000000000000000000000000abc")
+	tokenID := big.NewInt(7)
+	a := balanceSlot(tokenID, holder, 0)
+	b := balanceSlot(tokenID, holder, 0)
+	if a != b {
+		t.Fatalf("expected balanceSlot to be deterministic, got %s and %s", a, b)
+	}
+}
+
+func TestBalanceSlotVariesByHolder(t *testing.T) {
+	tokenID := big.NewInt(7)
+	holderA := common.HexToAddress("0x00000000000000000000000000000000000abc")
+	holderB := common.HexToAddress("0x00000000000000000000000000000000000def")
+	if balanceSlot(tokenID, holderA, 0) == balanceSlot(tokenID, holderB, 0) {
+		t.Fatalf("expected different holders to produce different keys")
+	}
+}
+
+func TestBalanceSlotVariesBySlotIndex(t *testing.T) {
+	holder := common.HexToAddress("0x00000000000000000000000000000000000abc")
+	tokenID := big.NewInt(7)
+	if balanceSlot(tokenID, holder, 0) == balanceSlot(tokenID, holder, 1) {
+		t.Fatalf("expected different slot indices to produce different keys")
+	}
+}
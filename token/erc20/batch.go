@@ -0,0 +1,94 @@
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+	"github.com/vocdoni/storage-proofs-eth-go/helpers"
+)
+
+// DefaultProofBatchSize is the default number of eth_getProof requests
+// pipelined per BatchCallContext round-trip, used by GetProofsBatch when
+// BatchSize is unset.
+const DefaultProofBatchSize = 100
+
+// GetProofsBatch calls eth_getProof for many holders in as few RPC
+// round-trips as possible, pipelining the requests via
+// rpc.Client.BatchCallContext in chunks of BatchSize (DefaultProofBatchSize
+// when unset). The block header is fetched once and its state root/height
+// are fanned out onto every returned StorageProof. Holders that
+// individually fail are omitted from the result; if any did, a joined
+// error describing them is returned alongside the holders that succeeded.
+func (w *ERC20Token) GetProofsBatch(ctx context.Context, holderKeys map[common.Address][][]byte,
+	block *big.Int) (map[common.Address]*ethstorageproof.StorageProof, error) {
+	blockData, err := w.EthCli.BlockByNumber(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := w.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultProofBatchSize
+	}
+	holders := make([]common.Address, 0, len(holderKeys))
+	for holder := range holderKeys {
+		holders = append(holders, holder)
+	}
+
+	results := make(map[common.Address]*ethstorageproof.StorageProof, len(holders))
+	var errs []error
+	blockArg := helpers.ToBlockNumArg(block)
+
+	for _, chunk := range chunkAddresses(holders, batchSize) {
+		elems := make([]rpc.BatchElem, len(chunk))
+		proofs := make([]ethstorageproof.StorageProof, len(chunk))
+		for i, holder := range chunk {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_getProof",
+				Args: []interface{}{
+					fmt.Sprintf("0x%x", w.TokenAddr),
+					ethstorageproof.SliceData(holderKeys[holder]),
+					blockArg,
+				},
+				Result: &proofs[i],
+			}
+		}
+		if err := w.RPCCli.BatchCallContext(ctx, elems); err != nil {
+			errs = append(errs, fmt.Errorf("batch call failed: %w", err))
+			continue
+		}
+		for i, holder := range chunk {
+			if elems[i].Error != nil {
+				errs = append(errs, fmt.Errorf("proof for %s: %w", holder, elems[i].Error))
+				continue
+			}
+			proofs[i].StateRoot = blockData.Root()
+			proofs[i].Height = blockData.Header().Number
+			results[holder] = &proofs[i]
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, errors.Join(errs...)
+	}
+	return results, nil
+}
+
+// chunkAddresses splits holders into consecutive slices of at most size
+// addresses each, preserving order.
+func chunkAddresses(holders []common.Address, size int) [][]common.Address {
+	var chunks [][]common.Address
+	for start := 0; start < len(holders); start += size {
+		end := start + size
+		if end > len(holders) {
+			end = len(holders)
+		}
+		chunks = append(chunks, holders[start:end])
+	}
+	return chunks
+}
@@ -0,0 +1,48 @@
+package erc20
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func addresses(n int) []common.Address {
+	out := make([]common.Address, n)
+	for i := range out {
+		out[i] = common.BigToAddress(common.Big1)
+	}
+	return out
+}
+
+func TestChunkAddressesEvenSplit(t *testing.T) {
+	chunks := chunkAddresses(addresses(10), 5)
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(chunks))
+	}
+	if len(chunks[0]) != 5 || len(chunks[1]) != 5 {
+		t.Fatalf("expected 5/5 split, got %d/%d", len(chunks[0]), len(chunks[1]))
+	}
+}
+
+func TestChunkAddressesRemainder(t *testing.T) {
+	chunks := chunkAddresses(addresses(11), 5)
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+	if len(chunks[2]) != 1 {
+		t.Fatalf("expected last chunk to hold the remainder of 1, got %d", len(chunks[2]))
+	}
+}
+
+func TestChunkAddressesEmpty(t *testing.T) {
+	if chunks := chunkAddresses(nil, 5); chunks != nil {
+		t.Fatalf("expected no chunks for no holders, got %d", len(chunks))
+	}
+}
+
+func TestChunkAddressesSizeLargerThanInput(t *testing.T) {
+	chunks := chunkAddresses(addresses(3), 100)
+	if len(chunks) != 1 || len(chunks[0]) != 3 {
+		t.Fatalf("expected a single chunk of 3, got %v", chunks)
+	}
+}
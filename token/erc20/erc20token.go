@@ -2,6 +2,7 @@ package erc20
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -13,9 +14,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
+// ErrSlotNotFound represents the storage slot not found error
+var ErrSlotNotFound = errors.New("storage slot not found")
+
+const maxIterationsForDiscoverSlot = 20
+
 // ERC20Token holds a reference to a go-ethereum client,
 // to an ERC20 like contract and to an ENS.
 // It is expected for the ERC20 contract to implement the standard
@@ -25,6 +32,18 @@ type ERC20Token struct {
 	EthCli    *ethclient.Client
 	token     *contracts.TokenCaller
 	TokenAddr common.Address
+
+	// ImplementationAddr is set when the token was constructed with
+	// NewWithOptions and ResolveProxy (or ImplementationAddr) resolved an
+	// EIP-1967 implementation. The storage root targeted by GetProof is
+	// always TokenAddr's, since that is where proxied storage lives.
+	ImplementationAddr common.Address
+	// BalanceSlot overrides DiscoverSlot when set.
+	BalanceSlot *int
+	// BatchSize caps how many eth_getProof requests GetProofsBatch
+	// pipelines per BatchCallContext round-trip. DefaultProofBatchSize is
+	// used when unset.
+	BatchSize int
 }
 
 // New creates a new ERC20Token to access ERC20 token data and get storage proofs
@@ -43,6 +62,17 @@ func New(ctx context.Context, rpcCli *rpc.Client,
 	}, nil
 }
 
+// TokenData holds the token metadata GetTokenData gathers in one round of
+// calls: name/symbol/decimals/totalSupply, plus the contract address they
+// were read from.
+type TokenData struct {
+	Address     common.Address
+	Name        string
+	Symbol      string
+	Decimals    uint8
+	TotalSupply *big.Int
+}
+
 // GetTokenData gets useful data abount the token
 func (w *ERC20Token) GetTokenData(ctx context.Context) (*TokenData, error) {
 	td := &TokenData{Address: w.TokenAddr}
@@ -112,22 +142,77 @@ func (w *ERC20Token) TokenTotalSupply(ctx context.Context) (*big.Int, error) {
 // the latest block will be retreived.
 func (w *ERC20Token) GetProof(ctx context.Context, keys [][]byte,
 	block *big.Int) (*ethstorageproof.StorageProof, error) {
-	blockData, err := w.EthCli.BlockByNumber(ctx, block)
+	return ethstorageproof.FetchProof(ctx, w.RPCCli, w.EthCli, w.TokenAddr, keys, block)
+}
+
+// DiscoverSlot tries to find the map index slot for the standard ERC20
+// `mapping(address => uint256)` balances layout, brute-forcing slot
+// indices and matching the decoded storage value against BalanceOf.
+// Most Solidity ERC20 implementations derive the storage key as
+// keccak256(leftPad32(holder) ++ leftPad32(slotIndex)), but some Vyper
+// contracts swap the key/slot ordering, so both are tried at each index.
+//
+// A holder with a zero balance can't be discovered this way: slot 0 of an
+// untouched mapping entry is zero too, so the first index would falsely
+// "match". DiscoverSlot returns ErrSlotNotFound rather than reporting a
+// bogus slot in that case.
+func (w *ERC20Token) DiscoverSlot(ctx context.Context,
+	holder common.Address) (int, *big.Rat, error) {
+	balance, err := w.Balance(ctx, holder)
 	if err != nil {
-		return nil, err
+		return -1, nil, err
 	}
-	var resp ethstorageproof.StorageProof
-	if err := w.RPCCli.CallContext(
-		ctx,
-		&resp,
-		"eth_getProof",
-		fmt.Sprintf("0x%x", w.TokenAddr),
-		ethstorageproof.SliceData(keys),
-		helpers.ToBlockNumArg(block),
-	); err != nil {
-		return nil, err
+	if w.BalanceSlot != nil {
+		return *w.BalanceSlot, balance, nil
+	}
+	if balance.Sign() == 0 {
+		return -1, nil, ErrSlotNotFound
+	}
+	decimals, err := w.TokenDecimals(ctx)
+	if err != nil {
+		return -1, nil, err
 	}
-	resp.StateRoot = blockData.Root()
-	resp.Height = blockData.Header().Number
-	return &resp, nil
+
+	return bruteForceBalanceSlot(ctx, w.EthCli, w.TokenAddr, holder, balance, decimals)
+}
+
+// storageAtReader is the minimal interface bruteForceBalanceSlot needs to
+// read a raw storage slot; *ethclient.Client satisfies it, and tests
+// substitute a SimulatedBackend to exercise slot discovery against real
+// deployed contract state instead of just the hash helpers.
+type storageAtReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash,
+		blockNumber *big.Int) ([]byte, error)
+}
+
+// bruteForceBalanceSlot is DiscoverSlot's search loop, factored out so it
+// can be exercised directly against a storageAtReader in tests.
+func bruteForceBalanceSlot(ctx context.Context, eth storageAtReader, tokenAddr,
+	holder common.Address, balance *big.Rat, decimals uint8) (int, *big.Rat, error) {
+	for i := 0; i < maxIterationsForDiscoverSlot; i++ {
+		for _, key := range []common.Hash{
+			helpers.GetMapSlot(holder, i),
+			swappedMapSlot(holder, i),
+		} {
+			value, err := eth.StorageAt(ctx, tokenAddr, key, nil)
+			if err != nil {
+				return -1, nil, err
+			}
+			amount := helpers.BalanceToRat(new(big.Int).SetBytes(value), int(decimals))
+			if amount.Cmp(balance) == 0 {
+				return i, amount, nil
+			}
+		}
+	}
+	return -1, nil, ErrSlotNotFound
+}
+
+// swappedMapSlot computes the Vyper-style storage key for a
+// mapping(address => uint256), which hashes the slot index before the
+// holder address instead of after it.
+func swappedMapSlot(holder common.Address, slotIndex int) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(big.NewInt(int64(slotIndex)).Bytes(), 32),
+		common.LeftPadBytes(holder.Bytes(), 32),
+	)
 }
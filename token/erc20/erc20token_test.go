@@ -0,0 +1,75 @@
+package erc20
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/vocdoni/storage-proofs-eth-go/helpers"
+	"github.com/vocdoni/storage-proofs-eth-go/token/tokentest"
+)
+
+func TestSwappedMapSlotDiffersFromStandardOrdering(t *testing.T) {
+	holder := common.HexToAddress("0x00000000000000000000000000000000000abc")
+
+	for i := 0; i < 3; i++ {
+		standard := crypto.Keccak256Hash(
+			common.LeftPadBytes(holder.Bytes(), 32),
+			common.LeftPadBytes(big.NewInt(int64(i)).Bytes(), 32))
+		swapped := swappedMapSlot(holder, i)
+		if standard == swapped {
+			t.Fatalf("slot %d: swappedMapSlot should not match the standard key/slot ordering", i)
+		}
+	}
+}
+
+func TestSwappedMapSlotIsDeterministic(t *testing.T) {
+	holder := common.HexToAddress("0x00000000000000000000000000000000000abc")
+	a := swappedMapSlot(holder, 2)
+	b := swappedMapSlot(holder, 2)
+	if a != b {
+		t.Fatalf("expected swappedMapSlot to be deterministic, got %s and %s", a, b)
+	}
+}
+
+// TestBruteForceBalanceSlotAgainstRealContract runs DiscoverSlot's search
+// loop against a TestToken deployed on tokentest's SimulatedBackend, so the
+// standard `mapping(address => uint256)` key derivation is checked against
+// real contract storage rather than just hash-determinism of the helper.
+func TestBruteForceBalanceSlotAgainstRealContract(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("build transactor: %v", err)
+	}
+
+	h, err := tokentest.NewHarness(auth)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	holder := common.HexToAddress("0x00000000000000000000000000000000000ab1")
+	if err := h.Mint(holder, big.NewInt(1234)); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+
+	balance := helpers.BalanceToRat(big.NewInt(1234), 18)
+	slot, amount, err := bruteForceBalanceSlot(ctx, h.Backend, h.ERC20Addr, holder, balance, 18)
+	if err != nil {
+		t.Fatalf("bruteForceBalanceSlot: %v", err)
+	}
+	if slot != 0 {
+		t.Fatalf("expected TestToken's balances mapping at slot 0, got %d", slot)
+	}
+	if amount.Cmp(balance) != 0 {
+		t.Fatalf("expected discovered amount %s, got %s", balance, amount)
+	}
+}
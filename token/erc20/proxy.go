@@ -0,0 +1,98 @@
+package erc20
+
+import (
+	"context"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// eip1967ImplementationSlot is keccak256("eip1967.proxy.implementation") - 1,
+// as defined by https://eips.ethereum.org/EIPS/eip-1967.
+var eip1967ImplementationSlot = common.HexToHash(
+	"0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// eip1967BeaconSlot is keccak256("eip1967.proxy.beacon") - 1, as defined by
+// https://eips.ethereum.org/EIPS/eip-1967.
+var eip1967BeaconSlot = common.HexToHash(
+	"0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+
+// beaconImplementationSelector is the 4-byte selector of implementation(),
+// the view function an UpgradeableBeacon exposes.
+var beaconImplementationSelector = []byte{0x5c, 0x60, 0xda, 0x1b}
+
+// Options customizes how NewWithOptions resolves and reads an ERC20
+// contract whose storage layout cannot be taken at face value.
+type Options struct {
+	// ResolveProxy, when set, follows the EIP-1967 implementation and
+	// beacon storage slots to discover the token's implementation
+	// address. GetProof still targets the proxy's own storage, since
+	// that is where the proxied balances live.
+	ResolveProxy bool
+	// ImplementationAddr bypasses proxy resolution when the
+	// implementation address is already known.
+	ImplementationAddr common.Address
+	// BalanceSlotOverride bypasses DiscoverSlot for tokens whose balance
+	// mapping slot is known but cannot be brute-forced, e.g. because
+	// they intentionally shadow the standard mapping layout.
+	BalanceSlotOverride *int
+}
+
+// NewWithOptions creates a new ERC20Token the same way New does, additionally
+// applying opts to resolve EIP-1967 proxies and/or override storage slots
+// that cannot be introspected.
+func NewWithOptions(ctx context.Context, rpcCli *rpc.Client,
+	contractAddress common.Address, opts Options) (*ERC20Token, error) {
+	w, err := New(ctx, rpcCli, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case opts.ImplementationAddr != (common.Address{}):
+		w.ImplementationAddr = opts.ImplementationAddr
+	case opts.ResolveProxy:
+		impl, err := w.resolveProxyImplementation(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve proxy implementation: %w", err)
+		}
+		w.ImplementationAddr = impl
+	}
+
+	w.BalanceSlot = opts.BalanceSlotOverride
+
+	return w, nil
+}
+
+// resolveProxyImplementation reads the EIP-1967 implementation slot and,
+// if empty, follows the beacon slot to the beacon contract and calls its
+// implementation() function.
+func (w *ERC20Token) resolveProxyImplementation(ctx context.Context) (common.Address, error) {
+	implValue, err := w.EthCli.StorageAt(ctx, w.TokenAddr, eip1967ImplementationSlot, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if impl := common.BytesToAddress(implValue); impl != (common.Address{}) {
+		return impl, nil
+	}
+
+	beaconValue, err := w.EthCli.StorageAt(ctx, w.TokenAddr, eip1967BeaconSlot, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	beacon := common.BytesToAddress(beaconValue)
+	if beacon == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("no EIP-1967 implementation or beacon slot set")
+	}
+
+	result, err := w.EthCli.CallContract(ctx, ethereum.CallMsg{
+		To:   &beacon,
+		Data: beaconImplementationSelector,
+	}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("cannot call beacon implementation(): %w", err)
+	}
+	return common.BytesToAddress(result), nil
+}
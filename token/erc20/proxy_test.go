@@ -0,0 +1,43 @@
+package erc20
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestNewWithOptionsImplementationAddr(t *testing.T) {
+	tokenAddr := common.HexToAddress("0x0000000000000000000000000000000000aaaa")
+	implAddr := common.HexToAddress("0x0000000000000000000000000000000000bbbb")
+	slot := 3
+
+	w, err := NewWithOptions(context.Background(), nil, tokenAddr, Options{
+		ImplementationAddr:  implAddr,
+		BalanceSlotOverride: &slot,
+	})
+	if err != nil {
+		t.Fatalf("NewWithOptions: %v", err)
+	}
+	if w.ImplementationAddr != implAddr {
+		t.Fatalf("expected ImplementationAddr %s, got %s", implAddr, w.ImplementationAddr)
+	}
+	if w.BalanceSlot == nil || *w.BalanceSlot != slot {
+		t.Fatalf("expected BalanceSlot %d, got %v", slot, w.BalanceSlot)
+	}
+}
+
+func TestNewWithOptionsNoOptions(t *testing.T) {
+	tokenAddr := common.HexToAddress("0x0000000000000000000000000000000000aaaa")
+
+	w, err := NewWithOptions(context.Background(), nil, tokenAddr, Options{})
+	if err != nil {
+		t.Fatalf("NewWithOptions: %v", err)
+	}
+	if w.ImplementationAddr != (common.Address{}) {
+		t.Fatalf("expected zero ImplementationAddr, got %s", w.ImplementationAddr)
+	}
+	if w.BalanceSlot != nil {
+		t.Fatalf("expected nil BalanceSlot, got %v", *w.BalanceSlot)
+	}
+}
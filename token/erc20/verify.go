@@ -0,0 +1,12 @@
+package erc20
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+)
+
+// VerifyAgainstHeader verifies a storage proof purely against a trusted
+// block header, with no RPC dependency. See ethstorageproof.VerifyAgainstHeader.
+func VerifyAgainstHeader(proof *ethstorageproof.StorageProof, header *types.Header) error {
+	return ethstorageproof.VerifyAgainstHeader(proof, header)
+}
@@ -0,0 +1,101 @@
+package erc721
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+	contracts "github.com/vocdoni/storage-proofs-eth-go/ierc721"
+)
+
+// ErrSlotNotFound represents the storage slot not found error
+var ErrSlotNotFound = errors.New("storage slot not found")
+
+const maxIterationsForDiscover = 20
+
+// ERC721Token holds a reference to a go-ethereum client and to an ERC721
+// like contract.
+type ERC721Token struct {
+	RPCCli    *rpc.Client
+	EthCli    *ethclient.Client
+	token     *contracts.TokenCaller
+	TokenAddr common.Address
+}
+
+// New creates a new ERC721Token to access ERC721 token data and get
+// ownership storage proofs
+func New(ctx context.Context, rpcCli *rpc.Client,
+	contractAddress common.Address) (*ERC721Token, error) {
+	ethCli := ethclient.NewClient(rpcCli)
+	token, err := contracts.NewTokenCaller(contractAddress, ethCli)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721Token{
+		RPCCli:    rpcCli,
+		EthCli:    ethCli,
+		token:     token,
+		TokenAddr: contractAddress,
+	}, nil
+}
+
+// DiscoverSlot tries to find the map index slot for the `_owners`
+// mapping(uint256 => address), brute-forcing slot indices and matching the
+// decoded storage value against ownerOf.
+//
+// A tokenID owned by the zero address can't be discovered this way: slot 0
+// of an untouched mapping entry is the zero address too, so the first
+// index would falsely "match". DiscoverSlot returns ErrSlotNotFound rather
+// than reporting a bogus slot in that case.
+func (w *ERC721Token) DiscoverSlot(ctx context.Context,
+	tokenID *big.Int) (int, common.Address, error) {
+	owner, err := w.token.OwnerOf(&bind.CallOpts{Context: ctx}, tokenID)
+	if err != nil {
+		return -1, common.Address{}, err
+	}
+	if owner == (common.Address{}) {
+		return -1, common.Address{}, ErrSlotNotFound
+	}
+
+	for i := 0; i < maxIterationsForDiscover; i++ {
+		value, err := w.EthCli.StorageAt(ctx, w.TokenAddr, ownerSlot(tokenID, i), nil)
+		if err != nil {
+			return -1, common.Address{}, err
+		}
+		if got := common.BytesToAddress(value); got == owner {
+			return i, owner, nil
+		}
+	}
+	return -1, common.Address{}, ErrSlotNotFound
+}
+
+// GetOwnershipProof returns a storage proof for tokenID's owner at block,
+// discovering the `_owners` mapping slot automatically. If block is nil,
+// the proof at the latest block will be retrieved.
+func (w *ERC721Token) GetOwnershipProof(ctx context.Context, tokenID *big.Int,
+	block *big.Int) (*ethstorageproof.StorageProof, error) {
+	slotIndex, _, err := w.DiscoverSlot(ctx, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot discover owners slot: %w", err)
+	}
+	key := ownerSlot(tokenID, slotIndex)
+	return ethstorageproof.FetchProof(ctx, w.RPCCli, w.EthCli, w.TokenAddr,
+		[][]byte{key.Bytes()}, block)
+}
+
+// ownerSlot computes the storage key for the `_owners` mapping(uint256 =>
+// address) at slotIndex: keccak256(leftPad32(tokenID) ++ leftPad32(slotIndex)).
+func ownerSlot(tokenID *big.Int, slotIndex int) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(tokenID.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(int64(slotIndex)).Bytes(), 32),
+	)
+}
@@ -0,0 +1,28 @@
+package erc721
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestOwnerSlotIsDeterministic(t *testing.T) {
+	tokenID := big.NewInt(42)
+	a := ownerSlot(tokenID, 0)
+	b := ownerSlot(tokenID, 0)
+	if a != b {
+		t.Fatalf("expected ownerSlot to be deterministic, got %s and %s", a, b)
+	}
+}
+
+func TestOwnerSlotVariesBySlotIndex(t *testing.T) {
+	tokenID := big.NewInt(42)
+	if ownerSlot(tokenID, 0) == ownerSlot(tokenID, 1) {
+		t.Fatalf("expected different slot indices to produce different keys")
+	}
+}
+
+func TestOwnerSlotVariesByTokenID(t *testing.T) {
+	if ownerSlot(big.NewInt(1), 0) == ownerSlot(big.NewInt(2), 0) {
+		t.Fatalf("expected different tokenIDs to produce different keys")
+	}
+}
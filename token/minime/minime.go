@@ -91,6 +91,18 @@ func (m *Minime) DiscoverSlot(ctx context.Context, holder common.Address) (int,
 // For block 87, we need to provide checkpoint 80 and 90
 func (m *Minime) GetProof(ctx context.Context, holder common.Address, block *big.Int,
 	islot int) (*ethstorageproof.StorageProof, error) {
+	keys, err := m.proofKeys(ctx, holder, block, islot)
+	if err != nil {
+		return nil, err
+	}
+	return m.erc20.GetProof(ctx, keys, block)
+}
+
+// proofKeys computes the one or two storage keys (checkpoint and, where
+// needed, a proof-of-nil) that a GetProof/GetProofsBatch call needs to
+// prove holder's balance at block for the checkpoints array at islot.
+func (m *Minime) proofKeys(ctx context.Context, holder common.Address, block *big.Int,
+	islot int) ([][]byte, error) {
 	checkPointsSize, err := m.getMinimeArraySize(ctx, holder, islot)
 	if err != nil {
 		return nil, fmt.Errorf("cannot fetch minime array size: %w", err)
@@ -141,7 +153,38 @@ func (m *Minime) GetProof(ctx context.Context, holder common.Address, block *big
 		return nil, fmt.Errorf("checkpoint not found")
 	}
 
-	return m.erc20.GetProof(ctx, keys, block)
+	return keys, nil
+}
+
+// GetProofsBatch returns storage proofs for many holders at a fixed block,
+// computing each holder's checkpoint keys and then pipelining all of them
+// through erc20.ERC20Token.GetProofsBatch so every holder rides the same
+// batch of eth_getProof calls.
+func (m *Minime) GetProofsBatch(ctx context.Context, holders []common.Address, block *big.Int,
+	islot int) (map[common.Address]*ethstorageproof.StorageProof, error) {
+	holderKeys := make(map[common.Address][][]byte, len(holders))
+	var errs []error
+	for _, holder := range holders {
+		keys, err := m.proofKeys(ctx, holder, block, islot)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("holder %s: %w", holder, err))
+			continue
+		}
+		holderKeys[holder] = keys
+	}
+
+	var proofs map[common.Address]*ethstorageproof.StorageProof
+	if len(holderKeys) > 0 {
+		var err error
+		if proofs, err = m.erc20.GetProofsBatch(ctx, holderKeys, block); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return proofs, errors.Join(errs...)
+	}
+	return proofs, nil
 }
 
 // VerifyProof verifies a minime storage proof
@@ -0,0 +1,19 @@
+package minime
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+)
+
+// VerifyAgainstHeader verifies a minime storage proof purely against a
+// trusted block header, with no RPC dependency. See
+// ethstorageproof.VerifyAgainstHeader.
+func VerifyAgainstHeader(proof *ethstorageproof.StorageProof, header *types.Header) error {
+	return ethstorageproof.VerifyAgainstHeader(proof, header)
+}
+
+// VerifyAgainstHeader verifies a minime storage proof purely against a
+// trusted block header, with no RPC dependency.
+func (m *Minime) VerifyAgainstHeader(proof *ethstorageproof.StorageProof, header *types.Header) error {
+	return VerifyAgainstHeader(proof, header)
+}
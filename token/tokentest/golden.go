@@ -0,0 +1,123 @@
+package tokentest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+	"github.com/vocdoni/storage-proofs-eth-go/helpers"
+)
+
+// GoldenVector is a self-contained fixture pairing a StorageProof with the
+// header it must verify against and the expected decoded result, so
+// VerifyProof/VerifyAgainstHeader can be regression-tested with no network.
+type GoldenVector struct {
+	Header          *types.Header                 `json:"header"`
+	Proof           *ethstorageproof.StorageProof `json:"proof"`
+	ExpectedBalance *big.Int                      `json:"expectedBalance"`
+	ExpectedBlock   *big.Int                      `json:"expectedBlock"`
+}
+
+// GenerateGoldenVectors walks every mined block and records a vector each
+// time the storage proof for keys[0] (the holder's balance slot) changes, so
+// proof verification can be exercised against exact-block checkpoints
+// without any RPC dependency. The balance is read back out of the proof
+// itself rather than via a contract call, since SimulatedBackend can only
+// eth_call against its latest block.
+func (h *Harness) GenerateGoldenVectors(ctx context.Context, holder common.Address,
+	keys []common.Hash) ([]GoldenVector, error) {
+	current := h.Backend.Blockchain().CurrentBlock().Number.Uint64()
+
+	var vectors []GoldenVector
+	var lastBalance *big.Int
+	for n := uint64(0); n <= current; n++ {
+		blockNum := new(big.Int).SetUint64(n)
+		proof, err := h.GetProof(ctx, h.ERC20Addr, keys, blockNum)
+		if err != nil {
+			return nil, fmt.Errorf("proof at block %d: %w", n, err)
+		}
+		balance := new(big.Int).SetBytes(proof.StorageProof[0].Value)
+		if lastBalance != nil && balance.Cmp(lastBalance) == 0 {
+			continue
+		}
+		lastBalance = balance
+
+		header, err := h.Backend.HeaderByNumber(ctx, blockNum)
+		if err != nil {
+			return nil, fmt.Errorf("header at block %d: %w", n, err)
+		}
+
+		vectors = append(vectors, GoldenVector{
+			Header:          header,
+			Proof:           proof,
+			ExpectedBalance: balance,
+			ExpectedBlock:   blockNum,
+		})
+	}
+	return vectors, nil
+}
+
+// GenerateMinimeGoldenVectors records, at the chain's current block, one
+// golden vector per checkpoint in holder's checkpoints[] array on the
+// MiniMe contract, plus a final vector for the slot right after the last
+// checkpoint. That last slot has never been written, so its proof is a
+// genuine proof-of-absence - the same checkpoint + proof-of-nil pair
+// token/minime.Minime.GetProof assembles for a real MiniMe token (see
+// Minime.proofKeys), mirrored here against testminime's checkpoint-array
+// storage layout (mapSlot = keccak256(holder . 0), entries at
+// keccak256(mapSlot)+i).
+func (h *Harness) GenerateMinimeGoldenVectors(ctx context.Context, holder common.Address) ([]GoldenVector, error) {
+	current := h.Backend.Blockchain().CurrentBlock().Number
+
+	mapSlot := helpers.GetMapSlot(holder, 0)
+	lengthRaw, err := h.Backend.StorageAt(ctx, h.MinimeAddr, mapSlot, current)
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint array length: %w", err)
+	}
+	length := new(big.Int).SetBytes(lengthRaw).Uint64()
+
+	baseSlot := helpers.HashFromPosition(mapSlot)
+	base := new(big.Int).SetBytes(baseSlot[:])
+
+	header, err := h.Backend.HeaderByNumber(ctx, current)
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	var vectors []GoldenVector
+	for i := uint64(0); i <= length; i++ {
+		slot := common.BigToHash(new(big.Int).Add(base, new(big.Int).SetUint64(i)))
+		proof, err := h.GetProof(ctx, h.MinimeAddr, []common.Hash{slot}, current)
+		if err != nil {
+			return nil, fmt.Errorf("minime proof at checkpoint %d: %w", i, err)
+		}
+
+		// Each checkpoint word packs fromBlock into its low 128 bits and
+		// value into its high 128 bits (see testminime's doc comment); the
+		// slot at index length has never been written, so both halves of
+		// its proven value come back zero.
+		word := common.LeftPadBytes(proof.StorageProof[0].Value, 32)
+		checkpointValue := new(big.Int).SetBytes(word[:16])
+		fromBlock := new(big.Int).SetBytes(word[16:])
+
+		vectors = append(vectors, GoldenVector{
+			Header:          header,
+			Proof:           proof,
+			ExpectedBalance: checkpointValue,
+			ExpectedBlock:   fromBlock,
+		})
+	}
+	return vectors, nil
+}
+
+// MarshalGoldenVectors renders vectors as indented JSON, ready to be
+// committed as reference fixtures for other consumers (zk circuits, other
+// language ports) to verify against.
+func MarshalGoldenVectors(vectors []GoldenVector) ([]byte, error) {
+	return json.MarshalIndent(vectors, "", "  ")
+}
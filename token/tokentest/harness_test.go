@@ -0,0 +1,180 @@
+package tokentest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/vocdoni/storage-proofs-eth-go/ethstorageproof"
+)
+
+func newTestAuth(t *testing.T) *bind.TransactOpts {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("build transactor: %v", err)
+	}
+	return auth
+}
+
+func TestHarnessMintAndProof(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuth(t)
+
+	h, err := NewHarness(auth)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	holder := common.HexToAddress("0x000000000000000000000000000000000000ab")
+	if err := h.Mint(holder, big.NewInt(1000)); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+
+	balanceSlot := crypto.Keccak256Hash(
+		common.LeftPadBytes(holder.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(0).Bytes(), 32),
+	)
+	proof, err := h.GetProof(ctx, h.ERC20Addr, []common.Hash{balanceSlot}, nil)
+	if err != nil {
+		t.Fatalf("GetProof: %v", err)
+	}
+	if len(proof.StorageProof) != 1 {
+		t.Fatalf("expected 1 storage proof, got %d", len(proof.StorageProof))
+	}
+	if proof.Height == nil || proof.Height.Sign() <= 0 {
+		t.Fatalf("expected a positive block height, got %v", proof.Height)
+	}
+}
+
+func TestGenerateGoldenVectors(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuth(t)
+
+	h, err := NewHarness(auth)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	holder := common.HexToAddress("0x000000000000000000000000000000000000cd")
+	if err := h.Mint(h.Auth.From, big.NewInt(500)); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if _, err := h.Transfer(holder, big.NewInt(250)); err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+
+	balanceSlot := crypto.Keccak256Hash(
+		common.LeftPadBytes(holder.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(0).Bytes(), 32),
+	)
+	vectors, err := h.GenerateGoldenVectors(ctx, holder, []common.Hash{balanceSlot})
+	if err != nil {
+		t.Fatalf("GenerateGoldenVectors: %v", err)
+	}
+	if len(vectors) < 2 {
+		t.Fatalf("expected at least 2 vectors (mint + transfer), got %d", len(vectors))
+	}
+	if _, err := MarshalGoldenVectors(vectors); err != nil {
+		t.Fatalf("MarshalGoldenVectors: %v", err)
+	}
+}
+
+// TestGenerateMinimeGoldenVectors exercises the checkpoint-array path:
+// auth.From accrues two checkpoints (mint, then the transfer's debit), and
+// an untouched holder proves the genuinely-never-written absence case.
+func TestGenerateMinimeGoldenVectors(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuth(t)
+
+	h, err := NewHarness(auth)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	holder := common.HexToAddress("0x000000000000000000000000000000000000fa")
+	if err := h.Mint(h.Auth.From, big.NewInt(1000)); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if _, err := h.Transfer(holder, big.NewInt(400)); err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+
+	vectors, err := h.GenerateMinimeGoldenVectors(ctx, h.Auth.From)
+	if err != nil {
+		t.Fatalf("GenerateMinimeGoldenVectors: %v", err)
+	}
+	if len(vectors) != 3 {
+		t.Fatalf("expected 2 checkpoints plus a proof-of-absence vector, got %d", len(vectors))
+	}
+	last := vectors[len(vectors)-1]
+	if last.ExpectedBalance.Sign() != 0 || last.ExpectedBlock.Sign() != 0 {
+		t.Fatalf("expected the final vector to prove an absent checkpoint, got value=%s fromBlock=%s",
+			last.ExpectedBalance, last.ExpectedBlock)
+	}
+	for i, v := range vectors {
+		if err := ethstorageproof.VerifyAgainstHeader(v.Proof, v.Header); err != nil {
+			t.Fatalf("VerifyAgainstHeader at checkpoint %d: %v", i, err)
+		}
+	}
+
+	untouched := common.HexToAddress("0x000000000000000000000000000000000000fb")
+	absentVectors, err := h.GenerateMinimeGoldenVectors(ctx, untouched)
+	if err != nil {
+		t.Fatalf("GenerateMinimeGoldenVectors (untouched): %v", err)
+	}
+	if len(absentVectors) != 1 {
+		t.Fatalf("expected exactly one proof-of-absence vector for an untouched holder, got %d",
+			len(absentVectors))
+	}
+	if err := ethstorageproof.VerifyAgainstHeader(absentVectors[0].Proof, absentVectors[0].Header); err != nil {
+		t.Fatalf("VerifyAgainstHeader (untouched): %v", err)
+	}
+}
+
+// TestGoldenVectorsVerifyAgainstHeader is the point of this whole package:
+// it round-trips every generated vector through VerifyAgainstHeader, so
+// VerifyProof/VerifyAgainstHeader can be regression-tested with no network.
+func TestGoldenVectorsVerifyAgainstHeader(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuth(t)
+
+	h, err := NewHarness(auth)
+	if err != nil {
+		t.Fatalf("NewHarness: %v", err)
+	}
+
+	holder := common.HexToAddress("0x000000000000000000000000000000000000ef")
+	if err := h.Mint(h.Auth.From, big.NewInt(1000)); err != nil {
+		t.Fatalf("Mint: %v", err)
+	}
+	if _, err := h.Transfer(holder, big.NewInt(400)); err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+
+	balanceSlot := crypto.Keccak256Hash(
+		common.LeftPadBytes(holder.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(0).Bytes(), 32),
+	)
+	vectors, err := h.GenerateGoldenVectors(ctx, holder, []common.Hash{balanceSlot})
+	if err != nil {
+		t.Fatalf("GenerateGoldenVectors: %v", err)
+	}
+	if len(vectors) == 0 {
+		t.Fatalf("expected at least one golden vector")
+	}
+
+	for _, v := range vectors {
+		if err := ethstorageproof.VerifyAgainstHeader(v.Proof, v.Header); err != nil {
+			t.Fatalf("VerifyAgainstHeader at block %s: %v", v.ExpectedBlock, err)
+		}
+	}
+}